@@ -0,0 +1,80 @@
+package iter
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChan adapts a channel into a Seq. ctx.Done() is checked before every
+// receive, so a cancelled context stops the stream even when ch still has
+// buffered items ready.
+func FromChan[V any](ctx context.Context, ch <-chan V) Seq[V] {
+	return func(yield func(V) bool) bool {
+		for {
+			select {
+			case <-ctx.Done():
+				return false
+			default:
+			}
+
+			select {
+			case <-ctx.Done():
+				return false
+			case v, ok := <-ch:
+				if !ok {
+					return true
+				}
+
+				if !yield(v) {
+					return false
+				}
+			}
+		}
+	}
+}
+
+// ToChan spawns a goroutine draining seq into the returned channel, closing it
+// once seq is exhausted or ctx is cancelled. Stopping reads from the channel
+// alone does not stop the goroutine: the returned stop func must be called
+// once the caller is done reading (e.g. via defer), mirroring the next/stop
+// pair returned by Pull, or the goroutine leaks blocked on sending.
+func ToChan[V any](ctx context.Context, seq Seq[V]) (<-chan V, func()) {
+	out := make(chan V)
+	done := make(chan struct{})
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() { close(done) })
+	}
+
+	go func() {
+		defer close(out)
+		seq(func(v V) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-done:
+				return false
+			case out <- v:
+				return true
+			}
+		})
+	}()
+
+	return out, stop
+}
+
+// ChanSend sinks seq into ch, returning ctx.Err() if ctx is cancelled before seq is exhausted.
+func ChanSend[V any](ctx context.Context, ch chan<- V, seq Seq[V]) error {
+	var err error
+	seq(func(v V) bool {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return false
+		case ch <- v:
+			return true
+		}
+	})
+	return err
+}
@@ -0,0 +1,42 @@
+package iter
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestToChanStopReleasesGoroutine guards against ToChan leaking its producer
+// goroutine when the caller abandons reading before the source is exhausted.
+func TestToChanStopReleasesGoroutine(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ch, stop := ToChan(context.Background(), FromMany(1, 2, 3, 4, 5))
+	<-ch
+	stop()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("goroutine count did not return to baseline: before=%d after=%d", before, runtime.NumGoroutine())
+}
+
+func TestToChanFullDrain(t *testing.T) {
+	ch, stop := ToChan(context.Background(), FromMany(1, 2, 3))
+	defer stop()
+
+	var got []int
+	for v := range ch {
+		got = append(got, v)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("got %v, want [1 2 3]", got)
+	}
+}
@@ -0,0 +1,191 @@
+package iter
+
+import (
+	"strings"
+
+	"github.com/rprtr258/fun"
+)
+
+// Reduce combines all elements of seq pairwise using op, left to right.
+// It returns false if seq is empty.
+func Reduce[V any](seq Seq[V], op func(V, V) V) (V, bool) {
+	var (
+		acc V
+		has bool
+	)
+	seq(func(v V) bool {
+		if !has {
+			acc, has = v, true
+			return true
+		}
+
+		acc = op(acc, v)
+		return true
+	})
+	return acc, has
+}
+
+// Fold reduces seq into a single accumulator, starting from init.
+func Fold[V, A any](seq Seq[V], init A, op func(A, V) A) A {
+	acc := init
+	seq(func(v V) bool {
+		acc = op(acc, v)
+		return true
+	})
+	return acc
+}
+
+// Aggregate folds seq into an accumulator starting from init, then runs
+// finish over the accumulated value to produce the result.
+func Aggregate[V, A, R any](seq Seq[V], init A, acc func(A, V) A, finish func(A) R) R {
+	return finish(Fold(seq, init, acc))
+}
+
+// Collector describes how to reduce a stream into a result: Identity supplies
+// the initial accumulator, Accumulate folds one element into it, and Finish
+// converts the accumulator into the final result.
+type Collector[V, A, R any] struct {
+	Identity   func() A
+	Accumulate func(A, V) A
+	Finish     func(A) R
+}
+
+// Collect drains seq through c, accumulating and finishing it into the result.
+func Collect[V, A, R any](seq Seq[V], c Collector[V, A, R]) R {
+	return Aggregate(seq, c.Identity(), c.Accumulate, c.Finish)
+}
+
+// ToMapC collects a stream into a map keyed and valued by keyFn/valFn,
+// later elements overwriting earlier ones on key collision.
+func ToMapC[V any, K comparable, R any](keyFn func(V) K, valFn func(V) R) Collector[V, map[K]R, map[K]R] {
+	return Collector[V, map[K]R, map[K]R]{
+		Identity: func() map[K]R { return map[K]R{} },
+		Accumulate: func(m map[K]R, v V) map[K]R {
+			m[keyFn(v)] = valFn(v)
+			return m
+		},
+		Finish: func(m map[K]R) map[K]R { return m },
+	}
+}
+
+// GroupBy collects a stream into groups keyed by keyFn, preserving encounter
+// order within each group.
+func GroupBy[V any, K comparable](keyFn func(V) K) Collector[V, map[K][]V, map[K][]V] {
+	return Collector[V, map[K][]V, map[K][]V]{
+		Identity: func() map[K][]V { return map[K][]V{} },
+		Accumulate: func(m map[K][]V, v V) map[K][]V {
+			k := keyFn(v)
+			m[k] = append(m[k], v)
+			return m
+		},
+		Finish: func(m map[K][]V) map[K][]V { return m },
+	}
+}
+
+// Partition holds the two groups produced by PartitionBy.
+type Partition[V any] struct {
+	True  []V
+	False []V
+}
+
+// PartitionBy splits a stream in two according to pred, preserving encounter
+// order within each group.
+func PartitionBy[V any](pred func(V) bool) Collector[V, Partition[V], Partition[V]] {
+	return Collector[V, Partition[V], Partition[V]]{
+		Identity: func() Partition[V] { return Partition[V]{} },
+		Accumulate: func(p Partition[V], v V) Partition[V] {
+			if pred(v) {
+				p.True = append(p.True, v)
+			} else {
+				p.False = append(p.False, v)
+			}
+
+			return p
+		},
+		Finish: func(p Partition[V]) Partition[V] { return p },
+	}
+}
+
+// CountingBy collects a stream into counts per key.
+func CountingBy[V any, K comparable](keyFn func(V) K) Collector[V, map[K]int, map[K]int] {
+	return Collector[V, map[K]int, map[K]int]{
+		Identity: func() map[K]int { return map[K]int{} },
+		Accumulate: func(m map[K]int, v V) map[K]int {
+			m[keyFn(v)]++
+			return m
+		},
+		Finish: func(m map[K]int) map[K]int { return m },
+	}
+}
+
+// JoiningStrings collects a stream of strings into one, separated by sep and
+// wrapped in prefix/suffix.
+func JoiningStrings(sep, prefix, suffix string) Collector[string, *strings.Builder, string] {
+	return Collector[string, *strings.Builder, string]{
+		Identity: func() *strings.Builder { return &strings.Builder{} },
+		Accumulate: func(b *strings.Builder, s string) *strings.Builder {
+			b.WriteString(sep)
+			b.WriteString(s)
+			return b
+		},
+		Finish: func(b *strings.Builder) string {
+			// every element was written after a sep, so drop the leading one
+			return prefix + strings.TrimPrefix(b.String(), sep) + suffix
+		},
+	}
+}
+
+// extremumBy collects the first element that no later element is better than,
+// i.e. the minimum or maximum depending on what better compares; on ties the
+// earliest element is kept. The result's V field reports whether the stream was non-empty.
+func extremumBy[V any](better func(V, V) bool) Collector[V, fun.Pair[V, bool], fun.Pair[V, bool]] {
+	return Collector[V, fun.Pair[V, bool], fun.Pair[V, bool]]{
+		Identity: func() fun.Pair[V, bool] { return fun.Pair[V, bool]{} },
+		Accumulate: func(acc fun.Pair[V, bool], v V) fun.Pair[V, bool] {
+			if !acc.V || better(v, acc.K) {
+				return fun.Pair[V, bool]{K: v, V: true}
+			}
+
+			return acc
+		},
+		Finish: func(acc fun.Pair[V, bool]) fun.Pair[V, bool] { return acc },
+	}
+}
+
+// MinBy collects the minimum element of a stream according to less. The
+// result's V field is false if the stream was empty.
+func MinBy[V any](less func(V, V) bool) Collector[V, fun.Pair[V, bool], fun.Pair[V, bool]] {
+	return extremumBy(less)
+}
+
+// MaxBy collects the maximum element of a stream according to less. The
+// result's V field is false if the stream was empty.
+func MaxBy[V any](less func(V, V) bool) Collector[V, fun.Pair[V, bool], fun.Pair[V, bool]] {
+	return extremumBy(func(a, b V) bool { return less(b, a) })
+}
+
+// averagingAcc accumulates a running sum and count for AveragingFloat.
+type averagingAcc struct {
+	sum   float64
+	count int
+}
+
+// AveragingFloat collects the arithmetic mean of fn applied to every element,
+// or 0 if the stream was empty.
+func AveragingFloat[V any](fn func(V) float64) Collector[V, averagingAcc, float64] {
+	return Collector[V, averagingAcc, float64]{
+		Identity: func() averagingAcc { return averagingAcc{} },
+		Accumulate: func(acc averagingAcc, v V) averagingAcc {
+			acc.sum += fn(v)
+			acc.count++
+			return acc
+		},
+		Finish: func(acc averagingAcc) float64 {
+			if acc.count == 0 {
+				return 0
+			}
+
+			return acc.sum / float64(acc.count)
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package iter
+
+import "testing"
+
+func TestReduceFoldAggregate(t *testing.T) {
+	sum, ok := Reduce(FromMany(1, 2, 3, 4), func(a, b int) int { return a + b })
+	if !ok || sum != 10 {
+		t.Fatalf("got (%d, %v), want (10, true)", sum, ok)
+	}
+
+	if _, ok := Reduce(FromMany[int](), func(a, b int) int { return a + b }); ok {
+		t.Fatal("expected ok=false for an empty stream")
+	}
+
+	folded := Fold(FromMany(1, 2, 3), 0, func(acc, v int) int { return acc + v })
+	if folded != 6 {
+		t.Fatalf("got %d, want 6", folded)
+	}
+
+	aggregated := Aggregate(FromMany(1, 2, 3), 0,
+		func(acc, v int) int { return acc + v },
+		func(acc int) int { return acc * 10 },
+	)
+	if aggregated != 60 {
+		t.Fatalf("got %d, want 60", aggregated)
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	min := Collect(FromMany(3, 1, 4, 1, 5), MinBy(less))
+	if !min.V || min.K != 1 {
+		t.Fatalf("got %+v, want {1 true}", min)
+	}
+
+	max := Collect(FromMany(3, 1, 4, 1, 5), MaxBy(less))
+	if !max.V || max.K != 5 {
+		t.Fatalf("got %+v, want {5 true}", max)
+	}
+}
+
+func TestMinByMaxByEmpty(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	if got := Collect(FromMany[int](), MinBy(less)); got.V {
+		t.Fatalf("got %+v, want V=false for an empty stream", got)
+	}
+
+	if got := Collect(FromMany[int](), MaxBy(less)); got.V {
+		t.Fatalf("got %+v, want V=false for an empty stream", got)
+	}
+}
+
+func TestAveragingFloat(t *testing.T) {
+	avg := Collect(FromMany(1, 2, 3, 4), AveragingFloat(func(v int) float64 { return float64(v) }))
+	if avg != 2.5 {
+		t.Fatalf("got %v, want 2.5", avg)
+	}
+}
+
+func TestAveragingFloatEmpty(t *testing.T) {
+	avg := Collect(FromMany[int](), AveragingFloat(func(v int) float64 { return float64(v) }))
+	if avg != 0 {
+		t.Fatalf("got %v, want 0 for an empty stream", avg)
+	}
+}
+
+func TestGroupByCountingByPartitionBy(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	groups := Collect(FromMany(1, 2, 3, 4, 5, 6), GroupBy(isEven))
+	if got, want := groups[true], []int{2, 4, 6}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := groups[false], []int{1, 3, 5}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	counts := Collect(FromMany(1, 2, 3, 4, 5, 6), CountingBy(isEven))
+	if counts[true] != 3 || counts[false] != 3 {
+		t.Fatalf("got %v, want {true:3 false:3}", counts)
+	}
+
+	part := Collect(FromMany(1, 2, 3, 4, 5, 6), PartitionBy(isEven))
+	if !equal(part.True, []int{2, 4, 6}) || !equal(part.False, []int{1, 3, 5}) {
+		t.Fatalf("got %+v", part)
+	}
+}
+
+func TestJoiningStrings(t *testing.T) {
+	got := Collect(FromMany("a", "b", "c"), JoiningStrings(", ", "[", "]"))
+	if got != "[a, b, c]" {
+		t.Fatalf("got %q, want %q", got, "[a, b, c]")
+	}
+}
+
+func TestToMapC(t *testing.T) {
+	got := Collect(FromMany(1, 2, 3), ToMapC(
+		func(v int) int { return v },
+		func(v int) int { return v * v },
+	))
+	if got[2] != 4 || got[3] != 9 {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func equal[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+// Package enc adapts standard library decoders and readers into iter.Seq2
+// streams, so large JSON arrays, XML documents, CSV files and line-oriented
+// text can be consumed without loading them fully into memory. Pair these
+// with iter.MapErr to strip and capture the first decode error.
+package enc
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/rprtr258/fun/iter"
+)
+
+// FromJSONArray reads the opening '[' token off dec and decodes elements of
+// the JSON array one at a time into T, yielding (value, nil) per element or
+// (zero, err) on the first decode failure, which stops the stream.
+func FromJSONArray[T any](dec *json.Decoder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) bool {
+		var zero T
+
+		tok, err := dec.Token()
+		if err != nil {
+			yield(zero, err)
+			return false
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			yield(zero, fmt.Errorf("enc: expected '[', got %v", tok))
+			return false
+		}
+
+		for dec.More() {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				yield(zero, err)
+				return false
+			}
+
+			if !yield(v, nil) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// FromXML decodes every XML element named localName as dec scans through the
+// document, yielding (value, nil) per element or (zero, err) on the first
+// decode failure, which stops the stream.
+func FromXML[T any](dec *xml.Decoder, localName string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) bool {
+		var zero T
+
+		for {
+			tok, err := dec.Token()
+			if err == io.EOF {
+				return true
+			}
+			if err != nil {
+				yield(zero, err)
+				return false
+			}
+
+			start, ok := tok.(xml.StartElement)
+			if !ok || start.Name.Local != localName {
+				continue
+			}
+
+			var v T
+			if err := dec.DecodeElement(&v, &start); err != nil {
+				yield(zero, err)
+				return false
+			}
+
+			if !yield(v, nil) {
+				return false
+			}
+		}
+	}
+}
+
+// FromCSV decodes every row read from r into T via decode, yielding
+// (value, nil) per row or (zero, err) on the first read or decode failure,
+// which stops the stream.
+func FromCSV[T any](r *csv.Reader, decode func([]string) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) bool {
+		var zero T
+
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				return true
+			}
+			if err != nil {
+				yield(zero, err)
+				return false
+			}
+
+			v, err := decode(record)
+			if err != nil {
+				yield(zero, err)
+				return false
+			}
+
+			if !yield(v, nil) {
+				return false
+			}
+		}
+	}
+}
+
+// Lines scans r line by line, yielding (line, nil) per line or ("", err) on
+// the first scan failure, which stops the stream.
+func Lines(r io.Reader) iter.Seq2[string, error] {
+	return LinesBuffer(r, 0)
+}
+
+// LinesBuffer is like Lines but lets the caller configure the scanner's
+// maximum token size in bytes, for files with lines longer than bufio's default.
+func LinesBuffer(r io.Reader, bufSize int) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) bool {
+		scanner := bufio.NewScanner(r)
+		if bufSize > 0 {
+			scanner.Buffer(make([]byte, 0, bufSize), bufSize)
+		}
+
+		for scanner.Scan() {
+			if !yield(scanner.Text(), nil) {
+				return false
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			yield("", err)
+			return false
+		}
+
+		return true
+	}
+}
@@ -0,0 +1,153 @@
+package enc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/rprtr258/fun/iter"
+)
+
+type pair struct {
+	A, B int
+}
+
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var (
+		vals []T
+		err  error
+	)
+	seq(func(v T, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+
+		vals = append(vals, v)
+		return true
+	})
+	return vals, err
+}
+
+func TestFromJSONArray(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`[1,2,3]`))
+	vals, err := collect(FromJSONArray[int](dec))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := vals, []int{1, 2, 3}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestFromJSONArray_NotAnArray(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`{"a":1}`))
+	vals, err := collect(FromJSONArray[int](dec))
+	if err == nil {
+		t.Fatal("expected an error for a non-array root, got none")
+	}
+	if len(vals) != 0 {
+		t.Fatalf("expected no values before the error, got %v", vals)
+	}
+}
+
+func TestFromJSONArray_MidStreamDecodeError(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`[1,"bad",3]`))
+	vals, err := collect(FromJSONArray[int](dec))
+	if err == nil {
+		t.Fatal("expected a decode error, got none")
+	}
+	if got, want := vals, []int{1}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+type xmlItem struct {
+	XMLName xml.Name `xml:"item"`
+	Text    string   `xml:",chardata"`
+}
+
+func TestFromXML(t *testing.T) {
+	dec := xml.NewDecoder(strings.NewReader(`<root><item>a</item><skip>x</skip><item>b</item></root>`))
+	vals, err := collect(FromXML[xmlItem](dec, "item"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vals) != 2 || vals[0].Text != "a" || vals[1].Text != "b" {
+		t.Fatalf("got %+v, want [a b]", vals)
+	}
+}
+
+func TestFromXML_DecodeError(t *testing.T) {
+	dec := xml.NewDecoder(strings.NewReader(`<root><item>a</item><item>`))
+	_, err := collect(FromXML[xmlItem](dec, "item"))
+	if err == nil {
+		t.Fatal("expected a decode error on truncated XML, got none")
+	}
+}
+
+func TestFromCSV(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("1,2\n3,4\n"))
+	vals, err := collect(FromCSV(r, func(record []string) (pair, error) {
+		return pair{A: int(record[0][0] - '0'), B: int(record[1][0] - '0')}, nil
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vals) != 2 || vals[0] != (pair{1, 2}) || vals[1] != (pair{3, 4}) {
+		t.Fatalf("got %+v", vals)
+	}
+}
+
+func TestFromCSV_DecodeError(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("1,2\nbad,4\n"))
+	vals, err := collect(FromCSV(r, func(record []string) (pair, error) {
+		if record[0] == "bad" {
+			return pair{}, errBad
+		}
+		return pair{A: int(record[0][0] - '0'), B: int(record[1][0] - '0')}, nil
+	}))
+	if err == nil {
+		t.Fatal("expected a decode error, got none")
+	}
+	if len(vals) != 1 {
+		t.Fatalf("expected one value before the error, got %v", vals)
+	}
+}
+
+func TestLines(t *testing.T) {
+	vals, err := collect(Lines(strings.NewReader("one\ntwo\nthree")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := vals, []string{"one", "two", "three"}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestLinesBuffer_TooLong(t *testing.T) {
+	_, err := collect(LinesBuffer(strings.NewReader(strings.Repeat("x", 100)), 16))
+	if err == nil {
+		t.Fatal("expected a scan error for a line exceeding the buffer size, got none")
+	}
+}
+
+var errBad = errBadT{}
+
+type errBadT struct{}
+
+func (errBadT) Error() string { return "bad record" }
+
+func equal[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
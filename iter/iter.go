@@ -173,6 +173,88 @@ func Chunked[A any](xs Seq[A], n int) Seq[[]A] {
 	}
 }
 
+// Window yields every contiguous sub-slice of length size, advancing by one
+// element between windows (Rust-style: yields nothing when xs has fewer than
+// size elements). Produced windows must not be retained across yields; use
+// WindowUnsafe to reuse the buffer instead of copying it.
+func Window[A any](xs Seq[A], size int) Seq[[]A] {
+	return slidingWindow(xs, size, 1, false)
+}
+
+// SlidingBy is like Window but advances by step elements between windows
+// instead of one. Produced windows must not be retained across yields.
+func SlidingBy[A any](xs Seq[A], size, step int) Seq[[]A] {
+	return slidingWindow(xs, size, step, false)
+}
+
+// WindowUnsafe behaves like Window but yields the same backing slice for every
+// window instead of a defensive copy, trading safety (the slice is
+// overwritten on the next yield) for zero allocation per window.
+func WindowUnsafe[A any](xs Seq[A], size int) Seq[[]A] {
+	return slidingWindow(xs, size, 1, true)
+}
+
+// slidingWindow maintains a ring buffer of capacity size, pushing each new
+// element in and, once full, yielding the window ordered from oldest to
+// newest before advancing the ring by step elements.
+func slidingWindow[A any](xs Seq[A], size, step int, unsafe bool) Seq[[]A] {
+	if size <= 0 {
+		panic(fmt.Sprintf("Window size must be positive, but %d given", size))
+	}
+	if step <= 0 {
+		panic(fmt.Sprintf("Window step must be positive, but %d given", step))
+	}
+
+	return func(yield func([]A) bool) bool {
+		ring := make([]A, size)
+		ordered := make([]A, size)
+		filled, head, toSkip := 0, 0, 0
+
+		emit := func() bool {
+			for i := 0; i < size; i++ {
+				ordered[i] = ring[(head+i)%size]
+			}
+
+			if unsafe {
+				return yield(ordered)
+			}
+
+			return yield(append([]A(nil), ordered...))
+		}
+
+		return xs(func(a A) bool {
+			if toSkip > 0 {
+				toSkip--
+				return true
+			}
+
+			ring[(head+filled)%size] = a
+			if filled < size {
+				filled++
+			} else {
+				head = (head + 1) % size
+			}
+
+			if filled < size {
+				return true
+			}
+
+			if !emit() {
+				return false
+			}
+
+			if step >= size {
+				filled, head, toSkip = 0, 0, step-size
+			} else {
+				filled -= step
+				head = (head + step) % size
+			}
+
+			return true
+		})
+	}
+}
+
 // Intersperse adds a separator after each stream element.
 func Intersperse[A any](xs Seq[A], sep A) Seq[A] {
 	return func(yield func(A) bool) bool {
@@ -313,6 +395,21 @@ func Unique[A comparable](xs Seq[A]) Seq[A] {
 	})
 }
 
+// UniqueBy makes stream of elements with unique keys, keeping the first
+// occurrence of each key and dropping the rest.
+func UniqueBy[A any, K comparable](xs Seq[A], key func(A) K) Seq[A] {
+	seen := set.New[K](0)
+	return Filter(xs, func(a A) bool {
+		k := key(a)
+		if seen.Contains(k) {
+			return false
+		}
+
+		seen.Add(k)
+		return true
+	})
+}
+
 // MapFilter applies function to every element and leaves only elements that are not None.
 func MapFilter[I, O any](seq Seq[I], f func(I) (O, bool)) Seq[O] {
 	return func(yield func(O) bool) bool {
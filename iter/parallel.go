@@ -0,0 +1,185 @@
+package iter
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/rprtr258/fun"
+)
+
+// ParallelOrder controls whether Parallel preserves the input order of results.
+type ParallelOrder int
+
+const (
+	// Ordered emits results in the same order as the input, buffering
+	// out-of-order results until their turn comes up.
+	Ordered ParallelOrder = iota
+	// Unordered emits results as soon as they are ready.
+	Unordered
+)
+
+type parallelConfig struct {
+	order            ParallelOrder
+	unlimitedWorkers bool
+}
+
+// ParallelOption customizes the behavior of Parallel, ParallelFilter and ParallelForEach.
+type ParallelOption func(*parallelConfig)
+
+// WithOrder picks whether results are emitted in input order or as soon as ready.
+func WithOrder(order ParallelOrder) ParallelOption {
+	return func(c *parallelConfig) {
+		c.order = order
+	}
+}
+
+// WithUnlimitedWorkers spawns a goroutine per element instead of a fixed pool of workers.
+func WithUnlimitedWorkers() ParallelOption {
+	return func(c *parallelConfig) {
+		c.unlimitedWorkers = true
+	}
+}
+
+// Parallel fans seq out to workers goroutines running f and streams the results downstream.
+// workers <= 0 means one worker per CPU. Stopping the downstream consumer (returning false
+// from yield) cancels in-flight work and stops seq.
+func Parallel[I, O any](seq Seq[I], workers int, f func(I) O, opts ...ParallelOption) Seq[O] {
+	cfg := parallelConfig{order: Ordered}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return func(yield func(O) bool) bool {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		type job struct {
+			seq uint64
+			in  I
+		}
+		type result struct {
+			seq uint64
+			out O
+		}
+
+		jobs := make(chan job)
+		results := make(chan result)
+
+		var wg sync.WaitGroup
+		work := func(j job) {
+			defer wg.Done()
+			select {
+			case results <- result{j.seq, f(j.in)}:
+			case <-ctx.Done():
+			}
+		}
+
+		if cfg.unlimitedWorkers {
+			go func() {
+				for j := range jobs {
+					go work(j)
+				}
+			}()
+		} else {
+			for i := 0; i < workers; i++ {
+				go func() {
+					for j := range jobs {
+						work(j)
+					}
+				}()
+			}
+		}
+
+		// Hold the counter above zero for the whole production phase so the
+		// closer goroutine's Wait can never observe it hit zero before the
+		// first job-scoped Add below — sync.WaitGroup forbids a concurrent
+		// Add(positive) racing a Wait that could see a zero counter, and
+		// without this the closer could fire before any job is dispatched.
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+			var n uint64
+			seq(func(in I) bool {
+				wg.Add(1)
+				select {
+				case jobs <- job{n, in}:
+					n++
+					return true
+				case <-ctx.Done():
+					wg.Done()
+					return false
+				}
+			})
+		}()
+
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		if cfg.order == Unordered {
+			for r := range results {
+				if !yield(r.out) {
+					cancel()
+					for range results {
+					}
+					return false
+				}
+			}
+
+			return true
+		}
+
+		pending := map[uint64]O{}
+		var next uint64
+		for r := range results {
+			pending[r.seq] = r.out
+			for {
+				out, ok := pending[next]
+				if !ok {
+					break
+				}
+
+				delete(pending, next)
+				next++
+				if !yield(out) {
+					cancel()
+					for range results {
+					}
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+}
+
+// ParallelFilter runs p in parallel over seq and keeps the elements it accepts,
+// preserving input order unless WithOrder(Unordered) is given.
+func ParallelFilter[I any](seq Seq[I], workers int, p func(I) bool, opts ...ParallelOption) Seq[I] {
+	return MapFilter(
+		Parallel(seq, workers, func(in I) fun.Pair[I, bool] {
+			return fun.Pair[I, bool]{K: in, V: p(in)}
+		}, opts...),
+		func(pr fun.Pair[I, bool]) (I, bool) {
+			return pr.K, pr.V
+		},
+	)
+}
+
+// ParallelForEach runs f in parallel over seq for side effects, draining it to completion.
+// Results are collected unordered by default since f's return value is discarded.
+func ParallelForEach[I any](seq Seq[I], workers int, f func(I), opts ...ParallelOption) {
+	fullOpts := append([]ParallelOption{WithOrder(Unordered)}, opts...)
+	Parallel(seq, workers, func(in I) struct{} {
+		f(in)
+		return struct{}{}
+	}, fullOpts...).ForEach(func(struct{}) {})
+}
@@ -0,0 +1,33 @@
+package iter
+
+import (
+	"sort"
+	"testing"
+)
+
+// TestParallelFullDrain guards against the WaitGroup race where the closer
+// goroutine could observe a zero counter and close results before any job
+// was dispatched, silently dropping the whole stream. It's timing-dependent,
+// so it runs many iterations (ideally under -race) rather than once.
+func TestParallelFullDrain(t *testing.T) {
+	const n = 300
+
+	for i := 0; i < 50; i++ {
+		in := make([]int, n)
+		for j := range in {
+			in[j] = j
+		}
+
+		got := Parallel(FromMany(in...), 8, func(x int) int { return x * 2 }).ToSlice()
+		if len(got) != n {
+			t.Fatalf("run %d: got %d results, want %d", i, len(got), n)
+		}
+
+		sort.Ints(got)
+		for j, v := range got {
+			if v != j*2 {
+				t.Fatalf("run %d: got %d at index %d, want %d", i, v, j, j*2)
+			}
+		}
+	}
+}
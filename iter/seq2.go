@@ -0,0 +1,225 @@
+package iter
+
+import (
+	"fmt"
+
+	"github.com/rprtr258/fun"
+)
+
+// Seq2 is an iterator over sequences of pairs of values, most commonly key-value pairs.
+type Seq2[K, V any] func(yield func(K, V) bool) bool
+
+func (seq Seq2[K, V]) Filter(p func(K, V) bool) Seq2[K, V] {
+	return Filter2(seq, p)
+}
+
+func (seq Seq2[K, V]) Take(n int) Seq2[K, V] {
+	return Take2(seq, n)
+}
+
+func (seq Seq2[K, V]) Skip(n int) Seq2[K, V] {
+	return Skip2(seq, n)
+}
+
+func (seq Seq2[K, V]) ForEach(f func(K, V)) {
+	ForEach2(seq, f)
+}
+
+func (seq Seq2[K, V]) Keys() Seq[K] {
+	return Keys2(seq)
+}
+
+func (seq Seq2[K, V]) Values() Seq[V] {
+	return Values2(seq)
+}
+
+func (seq Seq2[K, V]) Swap() Seq2[V, K] {
+	return Swap(seq)
+}
+
+// Map2 converts pairs of the stream.
+func Map2[K, V, K2, V2 any](seq Seq2[K, V], f func(K, V) (K2, V2)) Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) bool {
+		return seq(func(k K, v V) bool {
+			return yield(f(k, v))
+		})
+	}
+}
+
+// Filter2 leaves in the stream only the pairs that satisfy the given predicate.
+func Filter2[K, V any](seq Seq2[K, V], p func(K, V) bool) Seq2[K, V] {
+	return func(yield func(K, V) bool) bool {
+		return seq(func(k K, v V) bool {
+			if p(k, v) && !yield(k, v) {
+				return false
+			}
+
+			return true
+		})
+	}
+}
+
+// FlatMap2 maps stream of pairs using function and concatenates result streams into one.
+func FlatMap2[K, V, K2, V2 any](seq Seq2[K, V], f func(K, V) Seq2[K2, V2]) Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) bool {
+		return seq(func(k K, v V) bool {
+			return f(k, v)(yield)
+		})
+	}
+}
+
+// Take2 cuts the stream after n pairs.
+func Take2[K, V any](xs Seq2[K, V], n int) Seq2[K, V] {
+	if n < 0 {
+		panic(fmt.Sprintf("Take2 size must be non-negative, but %d given", n))
+	}
+
+	return func(yield func(K, V) bool) bool {
+		took := 0
+		return xs(func(k K, v V) bool {
+			if took == n {
+				return false
+			}
+
+			took++
+			return yield(k, v)
+		})
+	}
+}
+
+// Skip2 skips n pairs in the stream.
+func Skip2[K, V any](xs Seq2[K, V], n int) Seq2[K, V] {
+	return func(yield func(K, V) bool) bool {
+		skipped := 0
+		return xs(func(k K, v V) bool {
+			if skipped == n {
+				return yield(k, v)
+			}
+
+			skipped++
+			return true
+		})
+	}
+}
+
+// ForEach2 calls f for every pair of the stream.
+func ForEach2[K, V any](seq Seq2[K, V], f func(K, V)) {
+	seq(func(k K, v V) bool {
+		f(k, v)
+		return true
+	})
+}
+
+// ToMap collects the stream of pairs into a map, later keys overwriting earlier ones.
+func ToMap[K comparable, V any](seq Seq2[K, V]) map[K]V {
+	m := map[K]V{}
+	seq(func(k K, v V) bool {
+		m[k] = v
+		return true
+	})
+	return m
+}
+
+// Keys2 projects the stream of pairs to the stream of keys.
+func Keys2[K, V any](seq Seq2[K, V]) Seq[K] {
+	return func(yield func(K) bool) bool {
+		return seq(func(k K, _ V) bool {
+			return yield(k)
+		})
+	}
+}
+
+// Values2 projects the stream of pairs to the stream of values.
+func Values2[K, V any](seq Seq2[K, V]) Seq[V] {
+	return func(yield func(V) bool) bool {
+		return seq(func(_ K, v V) bool {
+			return yield(v)
+		})
+	}
+}
+
+// Zip combines two streams into a stream of pairs, stopping as soon as either stream is exhausted.
+func Zip[K, V any](ks Seq[K], vs Seq[V]) Seq2[K, V] {
+	return func(yield func(K, V) bool) bool {
+		next, stop := Pull(vs)
+		defer stop()
+
+		return ks(func(k K) bool {
+			v, ok := next()
+			if !ok {
+				return false
+			}
+
+			return yield(k, v)
+		})
+	}
+}
+
+// Unzip splits a stream of pairs into independent streams of keys and of values.
+// Both returned streams replay seq from the start, so seq must be repeatable.
+func Unzip[K, V any](seq Seq2[K, V]) (Seq[K], Seq[V]) {
+	return Keys2(seq), Values2(seq)
+}
+
+// Enumerate pairs every element of the stream with its index, starting at 0.
+func Enumerate[V any](xs Seq[V]) Seq2[int, V] {
+	return func(yield func(int, V) bool) bool {
+		i := 0
+		return xs(func(v V) bool {
+			if !yield(i, v) {
+				return false
+			}
+
+			i++
+			return true
+		})
+	}
+}
+
+// Swap flips the keys and values of the stream.
+func Swap[K, V any](seq Seq2[K, V]) Seq2[V, K] {
+	return func(yield func(V, K) bool) bool {
+		return seq(func(k K, v V) bool {
+			return yield(v, k)
+		})
+	}
+}
+
+// Pairs converts a stream of key-value pairs into a stream of fun.Pair values.
+func Pairs[K, V any](seq Seq2[K, V]) Seq[fun.Pair[K, V]] {
+	return func(yield func(fun.Pair[K, V]) bool) bool {
+		return seq(func(k K, v V) bool {
+			return yield(fun.Pair[K, V]{K: k, V: v})
+		})
+	}
+}
+
+// Unpairs converts a stream of fun.Pair values into a stream of key-value pairs.
+func Unpairs[K, V any](seq Seq[fun.Pair[K, V]]) Seq2[K, V] {
+	return func(yield func(K, V) bool) bool {
+		return seq(func(p fun.Pair[K, V]) bool {
+			return yield(p.K, p.V)
+		})
+	}
+}
+
+// ErrBox carries the first error encountered while draining a Seq2[V, error] through MapErr.
+type ErrBox struct {
+	Err error
+}
+
+// MapErr strips errors out of a Seq2[V, error], stopping the stream on the first one
+// and stashing it into the returned box so the caller can check it after draining.
+func MapErr[V any](seq Seq2[V, error]) (Seq[V], *ErrBox) {
+	box := &ErrBox{}
+	return func(yield func(V) bool) bool {
+		return seq(func(v V, err error) bool {
+			if err != nil {
+				box.Err = err
+				return false
+			}
+
+			return yield(v)
+		})
+	}, box
+}
@@ -0,0 +1,78 @@
+package iter
+
+import (
+	"testing"
+
+	"github.com/rprtr258/fun"
+)
+
+func TestSeq2Basics(t *testing.T) {
+	seq := Unpairs(FromMany(
+		fun.Pair[string, int]{K: "a", V: 1},
+		fun.Pair[string, int]{K: "b", V: 2},
+	))
+
+	if got, want := seq.Keys().ToSlice(), []string{"a", "b"}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := seq.Values().ToSlice(), []int{1, 2}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	m := ToMap(seq)
+	if m["a"] != 1 || m["b"] != 2 || len(m) != 2 {
+		t.Fatalf("got %v", m)
+	}
+
+	swapped := Swap(seq).Keys().ToSlice()
+	if got, want := swapped, []int{1, 2}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	idxs := Keys2(Enumerate(FromMany("x", "y", "z"))).ToSlice()
+	if got, want := idxs, []int{0, 1, 2}; !equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestZip(t *testing.T) {
+	pairs := Pairs(Zip(FromMany(1, 2, 3), FromMany("a", "b"))).ToSlice()
+	if len(pairs) != 2 || pairs[0].K != 1 || pairs[0].V != "a" || pairs[1].K != 2 || pairs[1].V != "b" {
+		t.Fatalf("got %+v, want zip truncated to the shorter stream", pairs)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	src := Unpairs(FromMany(
+		fun.Pair[int, error]{K: 1, V: nil},
+		fun.Pair[int, error]{K: 2, V: nil},
+	))
+	ok, box := MapErr(src)
+	if got := ok.ToSlice(); !equal(got, []int{1, 2}) {
+		t.Fatalf("got %v, want [1 2]", got)
+	}
+	if box.Err != nil {
+		t.Fatalf("expected no error, got %v", box.Err)
+	}
+
+	boom := Unpairs(FromMany(
+		fun.Pair[int, error]{K: 1, V: nil},
+		fun.Pair[int, error]{K: 0, V: errBoom},
+		fun.Pair[int, error]{K: 3, V: nil},
+	))
+	stopped, box2 := MapErr(boom)
+	if got := stopped.ToSlice(); !equal(got, []int{1}) {
+		t.Fatalf("got %v, want [1]", got)
+	}
+	if box2.Err != errBoom {
+		t.Fatalf("got %v, want %v", box2.Err, errBoom)
+	}
+}
+
+type boomErr struct{}
+
+func (boomErr) Error() string { return "boom" }
+
+var errBoom = boomErr{}
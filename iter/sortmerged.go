@@ -0,0 +1,89 @@
+package iter
+
+import (
+	"container/heap"
+	"slices"
+)
+
+type mergeItem[V any] struct {
+	val V
+	src int
+}
+
+// mergeHeap is a min-heap of (headValue, sourceIndex) pairs ordered by less.
+type mergeHeap[V any] struct {
+	items []mergeItem[V]
+	less  func(V, V) int
+}
+
+func (h *mergeHeap[V]) Len() int           { return len(h.items) }
+func (h *mergeHeap[V]) Less(i, j int) bool { return h.less(h.items[i].val, h.items[j].val) < 0 }
+func (h *mergeHeap[V]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *mergeHeap[V]) Push(x any)         { h.items = append(h.items, x.(mergeItem[V])) }
+func (h *mergeHeap[V]) Pop() any {
+	old := h.items
+	n := len(old)
+	item := old[n-1]
+	h.items = old[:n-1]
+	return item
+}
+
+// SortMerged k-way merges already-sorted sequences into one sorted sequence
+// using a min-heap seeded from a pull iterator per source, in O(N log k) time
+// where k is the number of sources. Significantly cheaper than repeatedly
+// applying MergeFunc pairwise.
+func SortMerged[V any](seqs []Seq[V], less func(V, V) int) Seq[V] {
+	return func(yield func(V) bool) bool {
+		nexts := make([]func() (V, bool), len(seqs))
+		stops := make([]func(), len(seqs))
+		for i, seq := range seqs {
+			nexts[i], stops[i] = Pull(seq)
+		}
+		defer func() {
+			for _, stop := range stops {
+				stop()
+			}
+		}()
+
+		h := &mergeHeap[V]{less: less}
+		for i, next := range nexts {
+			if v, ok := next(); ok {
+				heap.Push(h, mergeItem[V]{val: v, src: i})
+			}
+		}
+
+		for h.Len() > 0 {
+			top := heap.Pop(h).(mergeItem[V])
+			if !yield(top.val) {
+				return false
+			}
+
+			if v, ok := nexts[top.src](); ok {
+				heap.Push(h, mergeItem[V]{val: v, src: top.src})
+			}
+		}
+
+		return true
+	}
+}
+
+// SortStream external-sorts a stream too large to sort in memory at once by
+// splitting it into chunkSize-sized chunks, sorting each chunk in memory,
+// then k-way merging the sorted chunks with SortMerged. Like every other
+// combinator in this package, nothing runs until the returned Seq is driven;
+// SortStream itself does no work. The merge still needs every chunk's sorted
+// run resident to guarantee global order, so peak memory is bounded by the
+// size of xs, not by chunkSize; chunking trades one big sort for several
+// smaller ones rather than bounding memory.
+func SortStream[V any](xs Seq[V], less func(V, V) int, chunkSize int) Seq[V] {
+	return func(yield func(V) bool) bool {
+		var chunks []Seq[V]
+		Chunked(xs, chunkSize)(func(chunk []V) bool {
+			slices.SortFunc(chunk, less)
+			chunks = append(chunks, FromMany(chunk...))
+			return true
+		})
+
+		return SortMerged(chunks, less)(yield)
+	}
+}
@@ -0,0 +1,63 @@
+package iter
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func cmpInt(a, b int) int { return a - b }
+
+func TestSortMerged(t *testing.T) {
+	seqs := []Seq[int]{
+		FromMany(1, 4, 7, 10),
+		FromMany(2, 3, 9),
+		FromMany(5, 6, 8),
+	}
+
+	got := SortMerged(seqs, cmpInt).ToSlice()
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortStream(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	in := make([]int, 237)
+	for i := range in {
+		in[i] = r.Intn(1000)
+	}
+
+	got := SortStream(FromMany(in...), cmpInt, 16).ToSlice()
+	if len(got) != len(in) {
+		t.Fatalf("got %d elements, want %d", len(got), len(in))
+	}
+
+	for i := 1; i < len(got); i++ {
+		if got[i-1] > got[i] {
+			t.Fatalf("output not sorted at index %d: %v", i, got)
+		}
+	}
+}
+
+// TestSortStreamLazy guards against SortStream draining xs eagerly before
+// returning a Seq: calling it must not touch xs at all until the returned
+// Seq is driven.
+func TestSortStreamLazy(t *testing.T) {
+	touched := false
+	xs := func(yield func(int) bool) bool {
+		touched = true
+		return yield(1)
+	}
+
+	_ = SortStream(Seq[int](xs), cmpInt, 4)
+	if touched {
+		t.Fatal("SortStream drained its source before the returned Seq was driven")
+	}
+}
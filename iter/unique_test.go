@@ -0,0 +1,22 @@
+package iter
+
+import "testing"
+
+func TestUniqueBy(t *testing.T) {
+	type item struct {
+		ID   int
+		Name string
+	}
+
+	items := FromMany(
+		item{ID: 1, Name: "a"},
+		item{ID: 2, Name: "b"},
+		item{ID: 1, Name: "c"},
+		item{ID: 3, Name: "d"},
+	)
+
+	got := UniqueBy(items, func(i item) int { return i.ID }).ToSlice()
+	if len(got) != 3 || got[0].Name != "a" || got[1].Name != "b" || got[2].Name != "d" {
+		t.Fatalf("got %+v, want first occurrence of each ID kept in order", got)
+	}
+}
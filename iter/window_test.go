@@ -0,0 +1,67 @@
+package iter
+
+import "testing"
+
+func TestWindow(t *testing.T) {
+	var got [][]int
+	Window(FromMany(1, 2, 3, 4, 5), 3)(func(w []int) bool {
+		got = append(got, append([]int(nil), w...))
+		return true
+	})
+
+	want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !equal(got[i], want[i]) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestWindow_FewerElementsThanSize(t *testing.T) {
+	var got [][]int
+	Window(FromMany(1, 2), 3)(func(w []int) bool {
+		got = append(got, w)
+		return true
+	})
+
+	if len(got) != 0 {
+		t.Fatalf("got %v, want no windows", got)
+	}
+}
+
+func TestSlidingBy(t *testing.T) {
+	var got [][]int
+	SlidingBy(FromMany(1, 2, 3, 4, 5, 6, 7), 2, 3)(func(w []int) bool {
+		got = append(got, append([]int(nil), w...))
+		return true
+	})
+
+	// the trailing partial window starting at element 7 is incomplete and dropped
+	wantFull := [][]int{{1, 2}, {4, 5}}
+	if len(got) != len(wantFull) {
+		t.Fatalf("got %v, want %v", got, wantFull)
+	}
+	for i := range wantFull {
+		if !equal(got[i], wantFull[i]) {
+			t.Fatalf("got %v, want %v", got, wantFull)
+		}
+	}
+}
+
+func TestWindowUnsafe_ReusesBuffer(t *testing.T) {
+	var ptrs []*int
+	WindowUnsafe(FromMany(1, 2, 3, 4), 2)(func(w []int) bool {
+		ptrs = append(ptrs, &w[0])
+		return true
+	})
+
+	if len(ptrs) < 2 {
+		t.Fatal("expected at least two windows")
+	}
+	if ptrs[0] != ptrs[1] {
+		t.Fatal("WindowUnsafe should reuse the same backing array across yields")
+	}
+}